@@ -1,10 +1,47 @@
 package us3
 
 import (
+	"fmt"
+
 	"github.com/beyondstorage/go-storage/v4/services"
 	"github.com/beyondstorage/go-storage/v4/types"
 )
 
+var (
+	// ErrStorageClassInvalid will be returned while storage class is not recognized by us3.
+	ErrStorageClassInvalid = services.NewErrorCode("invalid storage class")
+)
+
+// StorageClass is the storage class of an object in us3.
+type StorageClass string
+
+// All available storage classes are listed here.
+const (
+	// StorageClassStandard is the default storage class.
+	StorageClassStandard StorageClass = "STANDARD"
+	// StorageClassIA is the infrequent access storage class.
+	StorageClassIA StorageClass = "IA"
+	// StorageClassArchive is the archive storage class.
+	StorageClassArchive StorageClass = "ARCHIVE"
+)
+
+// String implements fmt.Stringer.
+func (c StorageClass) String() string {
+	return string(c)
+}
+
+// ParseStorageClass will parse storage class returned by us3.
+//
+// An error wrapping ErrStorageClassInvalid will be returned if v is not a known storage class.
+func ParseStorageClass(v string) (StorageClass, error) {
+	switch c := StorageClass(v); c {
+	case StorageClassStandard, StorageClassIA, StorageClassArchive:
+		return c, nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrStorageClassInvalid, v)
+	}
+}
+
 // Storage is the example client.
 type Storage struct {
 	defaultPairs DefaultStoragePairs
@@ -0,0 +1,52 @@
+package us3
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/beyondstorage/go-storage/v4/types"
+)
+
+func TestParseStorageClass(t *testing.T) {
+	cases := []struct {
+		name  string
+		input string
+		want  StorageClass
+		valid bool
+	}{
+		{"standard", StorageClassStandard.String(), StorageClassStandard, true},
+		{"ia", StorageClassIA.String(), StorageClassIA, true},
+		{"archive", StorageClassArchive.String(), StorageClassArchive, true},
+		{"unknown", "GLACIER", "", false},
+		{"empty", "", "", false},
+		{"lower case", "standard", "", false},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseStorageClass(tt.input)
+			if got != tt.want {
+				t.Errorf("ParseStorageClass(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+			if tt.valid {
+				if err != nil {
+					t.Errorf("ParseStorageClass(%q) returned unexpected error: %v", tt.input, err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrStorageClassInvalid) {
+				t.Errorf("ParseStorageClass(%q) error = %v, want ErrStorageClassInvalid", tt.input, err)
+			}
+			if err != nil && !strings.HasSuffix(err.Error(), ": "+tt.input) {
+				t.Errorf("ParseStorageClass(%q) error %q does not name the input", tt.input, err)
+			}
+		})
+	}
+}
+
+func TestStorageNotLinker(t *testing.T) {
+	if _, ok := interface{}(&Storage{}).(types.Linker); ok {
+		t.Error("Storage must not implement types.Linker until link objects are supported")
+	}
+}